@@ -0,0 +1,238 @@
+package api
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+// serviceName is the fully-qualified gRPC service name declared in api.proto.
+const serviceName = "bbsnode.api.API"
+
+// APIClient is the client API for the API service (see api.proto).
+type APIClient interface {
+	GetBoards(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Bytes, error)
+	NewBoard(ctx context.Context, in *Fields, opts ...grpc.CallOption) (*Bytes, error)
+	RemoveBoard(ctx context.Context, in *Fields, opts ...grpc.CallOption) (*Bytes, error)
+	GetBoardPage(ctx context.Context, in *Fields, opts ...grpc.CallOption) (*Bytes, error)
+	GetThreads(ctx context.Context, in *Fields, opts ...grpc.CallOption) (*Bytes, error)
+	NewThread(ctx context.Context, in *Fields, opts ...grpc.CallOption) (*Bytes, error)
+	RemoveThread(ctx context.Context, in *Fields, opts ...grpc.CallOption) (*Bytes, error)
+	GetThreadPage(ctx context.Context, in *Fields, opts ...grpc.CallOption) (*Bytes, error)
+	GetPosts(ctx context.Context, in *Fields, opts ...grpc.CallOption) (*Bytes, error)
+	NewPost(ctx context.Context, in *Fields, opts ...grpc.CallOption) (*Bytes, error)
+	RemovePost(ctx context.Context, in *Fields, opts ...grpc.CallOption) (*Bytes, error)
+	ImportThread(ctx context.Context, in *Fields, opts ...grpc.CallOption) (*Bytes, error)
+
+	SubscribeBoard(ctx context.Context, in *Fields, opts ...grpc.CallOption) (API_SubscribeBoardClient, error)
+	SubscribeThread(ctx context.Context, in *Fields, opts ...grpc.CallOption) (API_SubscribeThreadClient, error)
+	SubscribeBoardList(ctx context.Context, in *Fields, opts ...grpc.CallOption) (API_SubscribeBoardListClient, error)
+}
+
+type apiClient struct {
+	cc grpc.ClientConnInterface
+}
+
+// NewAPIClient returns an APIClient backed by cc.
+func NewAPIClient(cc grpc.ClientConnInterface) APIClient {
+	return &apiClient{cc}
+}
+
+// withCodec forces calls onto the jsonCodec registered in types.go, since this package carries
+// plain Go structs rather than protobuf messages.
+func withCodec(opts []grpc.CallOption) []grpc.CallOption {
+	return append([]grpc.CallOption{grpc.CallContentSubtype(codecName)}, opts...)
+}
+
+func (c *apiClient) invoke(ctx context.Context, method string, in, out interface{}, opts ...grpc.CallOption) error {
+	return c.cc.Invoke(ctx, "/"+serviceName+"/"+method, in, out, withCodec(opts)...)
+}
+
+func (c *apiClient) GetBoards(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Bytes, error) {
+	out := new(Bytes)
+	if err := c.invoke(ctx, "GetBoards", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) NewBoard(ctx context.Context, in *Fields, opts ...grpc.CallOption) (*Bytes, error) {
+	out := new(Bytes)
+	if err := c.invoke(ctx, "NewBoard", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) RemoveBoard(ctx context.Context, in *Fields, opts ...grpc.CallOption) (*Bytes, error) {
+	out := new(Bytes)
+	if err := c.invoke(ctx, "RemoveBoard", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) GetBoardPage(ctx context.Context, in *Fields, opts ...grpc.CallOption) (*Bytes, error) {
+	out := new(Bytes)
+	if err := c.invoke(ctx, "GetBoardPage", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) GetThreads(ctx context.Context, in *Fields, opts ...grpc.CallOption) (*Bytes, error) {
+	out := new(Bytes)
+	if err := c.invoke(ctx, "GetThreads", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) NewThread(ctx context.Context, in *Fields, opts ...grpc.CallOption) (*Bytes, error) {
+	out := new(Bytes)
+	if err := c.invoke(ctx, "NewThread", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) RemoveThread(ctx context.Context, in *Fields, opts ...grpc.CallOption) (*Bytes, error) {
+	out := new(Bytes)
+	if err := c.invoke(ctx, "RemoveThread", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) GetThreadPage(ctx context.Context, in *Fields, opts ...grpc.CallOption) (*Bytes, error) {
+	out := new(Bytes)
+	if err := c.invoke(ctx, "GetThreadPage", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) GetPosts(ctx context.Context, in *Fields, opts ...grpc.CallOption) (*Bytes, error) {
+	out := new(Bytes)
+	if err := c.invoke(ctx, "GetPosts", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) NewPost(ctx context.Context, in *Fields, opts ...grpc.CallOption) (*Bytes, error) {
+	out := new(Bytes)
+	if err := c.invoke(ctx, "NewPost", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) RemovePost(ctx context.Context, in *Fields, opts ...grpc.CallOption) (*Bytes, error) {
+	out := new(Bytes)
+	if err := c.invoke(ctx, "RemovePost", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) ImportThread(ctx context.Context, in *Fields, opts ...grpc.CallOption) (*Bytes, error) {
+	out := new(Bytes)
+	if err := c.invoke(ctx, "ImportThread", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) SubscribeBoard(ctx context.Context, in *Fields, opts ...grpc.CallOption) (API_SubscribeBoardClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[0], "/"+serviceName+"/SubscribeBoard", withCodec(opts)...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apiSubscribeBoardClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+func (c *apiClient) SubscribeThread(ctx context.Context, in *Fields, opts ...grpc.CallOption) (API_SubscribeThreadClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[1], "/"+serviceName+"/SubscribeThread", withCodec(opts)...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apiSubscribeThreadClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+func (c *apiClient) SubscribeBoardList(ctx context.Context, in *Fields, opts ...grpc.CallOption) (API_SubscribeBoardListClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[2], "/"+serviceName+"/SubscribeBoardList", withCodec(opts)...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apiSubscribeBoardListClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// API_SubscribeBoardClient is the client-side stream for SubscribeBoard.
+type API_SubscribeBoardClient interface {
+	Recv() (*Bytes, error)
+	grpc.ClientStream
+}
+
+type apiSubscribeBoardClient struct{ grpc.ClientStream }
+
+func (x *apiSubscribeBoardClient) Recv() (*Bytes, error) {
+	m := new(Bytes)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// API_SubscribeThreadClient is the client-side stream for SubscribeThread.
+type API_SubscribeThreadClient interface {
+	Recv() (*Bytes, error)
+	grpc.ClientStream
+}
+
+type apiSubscribeThreadClient struct{ grpc.ClientStream }
+
+func (x *apiSubscribeThreadClient) Recv() (*Bytes, error) {
+	m := new(Bytes)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// API_SubscribeBoardListClient is the client-side stream for SubscribeBoardList.
+type API_SubscribeBoardListClient interface {
+	Recv() (*Bytes, error)
+	grpc.ClientStream
+}
+
+type apiSubscribeBoardListClient struct{ grpc.ClientStream }
+
+func (x *apiSubscribeBoardListClient) Recv() (*Bytes, error) {
+	m := new(Bytes)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
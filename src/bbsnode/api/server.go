@@ -0,0 +1,145 @@
+package api
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+// APIServer is the server API for the API service (see api.proto).
+type APIServer interface {
+	GetBoards(context.Context, *Empty) (*Bytes, error)
+	NewBoard(context.Context, *Fields) (*Bytes, error)
+	RemoveBoard(context.Context, *Fields) (*Bytes, error)
+	GetBoardPage(context.Context, *Fields) (*Bytes, error)
+	GetThreads(context.Context, *Fields) (*Bytes, error)
+	NewThread(context.Context, *Fields) (*Bytes, error)
+	RemoveThread(context.Context, *Fields) (*Bytes, error)
+	GetThreadPage(context.Context, *Fields) (*Bytes, error)
+	GetPosts(context.Context, *Fields) (*Bytes, error)
+	NewPost(context.Context, *Fields) (*Bytes, error)
+	RemovePost(context.Context, *Fields) (*Bytes, error)
+	ImportThread(context.Context, *Fields) (*Bytes, error)
+
+	SubscribeBoard(*Fields, API_SubscribeBoardServer) error
+	SubscribeThread(*Fields, API_SubscribeThreadServer) error
+	SubscribeBoardList(*Fields, API_SubscribeBoardListServer) error
+}
+
+// API_SubscribeBoardServer is the server-side stream for SubscribeBoard.
+type API_SubscribeBoardServer interface {
+	Send(*Bytes) error
+	grpc.ServerStream
+}
+
+type apiSubscribeBoardServer struct{ grpc.ServerStream }
+
+func (x *apiSubscribeBoardServer) Send(m *Bytes) error { return x.ServerStream.SendMsg(m) }
+
+// API_SubscribeThreadServer is the server-side stream for SubscribeThread.
+type API_SubscribeThreadServer interface {
+	Send(*Bytes) error
+	grpc.ServerStream
+}
+
+type apiSubscribeThreadServer struct{ grpc.ServerStream }
+
+func (x *apiSubscribeThreadServer) Send(m *Bytes) error { return x.ServerStream.SendMsg(m) }
+
+// API_SubscribeBoardListServer is the server-side stream for SubscribeBoardList.
+type API_SubscribeBoardListServer interface {
+	Send(*Bytes) error
+	grpc.ServerStream
+}
+
+type apiSubscribeBoardListServer struct{ grpc.ServerStream }
+
+func (x *apiSubscribeBoardListServer) Send(m *Bytes) error { return x.ServerStream.SendMsg(m) }
+
+func _API_GetBoards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetBoards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + serviceName + "/GetBoards"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetBoards(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// unaryFieldsHandler builds a grpc.MethodDesc handler for a Fields-in/Bytes-out RPC, given the
+// APIServer method to dispatch to. Every unary RPC below GetBoards has this same shape.
+func unaryFieldsHandler(method string, call func(APIServer, context.Context, *Fields) (*Bytes, error)) func(interface{}, context.Context, func(interface{}) error, grpc.UnaryServerInterceptor) (interface{}, error) {
+	return func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+		in := new(Fields)
+		if err := dec(in); err != nil {
+			return nil, err
+		}
+		if interceptor == nil {
+			return call(srv.(APIServer), ctx, in)
+		}
+		info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + serviceName + "/" + method}
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			return call(srv.(APIServer), ctx, req.(*Fields))
+		}
+		return interceptor(ctx, in, info, handler)
+	}
+}
+
+func _API_SubscribeBoard_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Fields)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).SubscribeBoard(m, &apiSubscribeBoardServer{stream})
+}
+
+func _API_SubscribeThread_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Fields)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).SubscribeThread(m, &apiSubscribeThreadServer{stream})
+}
+
+func _API_SubscribeBoardList_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Fields)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).SubscribeBoardList(m, &apiSubscribeBoardListServer{stream})
+}
+
+var _API_serviceDesc = grpc.ServiceDesc{
+	ServiceName: serviceName,
+	HandlerType: (*APIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{MethodName: "GetBoards", Handler: _API_GetBoards_Handler},
+		{MethodName: "NewBoard", Handler: unaryFieldsHandler("NewBoard", APIServer.NewBoard)},
+		{MethodName: "RemoveBoard", Handler: unaryFieldsHandler("RemoveBoard", APIServer.RemoveBoard)},
+		{MethodName: "GetBoardPage", Handler: unaryFieldsHandler("GetBoardPage", APIServer.GetBoardPage)},
+		{MethodName: "GetThreads", Handler: unaryFieldsHandler("GetThreads", APIServer.GetThreads)},
+		{MethodName: "NewThread", Handler: unaryFieldsHandler("NewThread", APIServer.NewThread)},
+		{MethodName: "RemoveThread", Handler: unaryFieldsHandler("RemoveThread", APIServer.RemoveThread)},
+		{MethodName: "GetThreadPage", Handler: unaryFieldsHandler("GetThreadPage", APIServer.GetThreadPage)},
+		{MethodName: "GetPosts", Handler: unaryFieldsHandler("GetPosts", APIServer.GetPosts)},
+		{MethodName: "NewPost", Handler: unaryFieldsHandler("NewPost", APIServer.NewPost)},
+		{MethodName: "RemovePost", Handler: unaryFieldsHandler("RemovePost", APIServer.RemovePost)},
+		{MethodName: "ImportThread", Handler: unaryFieldsHandler("ImportThread", APIServer.ImportThread)},
+	},
+	Streams: []grpc.StreamDesc{
+		{StreamName: "SubscribeBoard", Handler: _API_SubscribeBoard_Handler, ServerStreams: true},
+		{StreamName: "SubscribeThread", Handler: _API_SubscribeThread_Handler, ServerStreams: true},
+		{StreamName: "SubscribeBoardList", Handler: _API_SubscribeBoardList_Handler, ServerStreams: true},
+	},
+	Metadata: "api.proto",
+}
+
+// RegisterAPIServer registers srv with s.
+func RegisterAPIServer(s grpc.ServiceRegistrar, srv APIServer) {
+	s.RegisterService(&_API_serviceDesc, srv)
+}
@@ -0,0 +1,49 @@
+// Package api implements the bbsnode.API gRPC service described in api.proto.
+//
+// The messages and service stubs in this package are hand-maintained to mirror api.proto, rather
+// than generated by protoc: they are plain Go structs carried over a JSON grpc codec instead of
+// the protobuf wire format, which keeps the service real and working without depending on
+// protoc/protoc-gen-go being available wherever this package is built. Keep api.proto and these
+// files in sync by hand until protoc codegen is wired into the build.
+package api
+
+import (
+	"encoding/json"
+
+	"google.golang.org/grpc/encoding"
+)
+
+// Empty carries no data. It mirrors the Empty message in api.proto.
+type Empty struct{}
+
+// Fields carries the same key/value pairs gui.Values would otherwise encode as HTTP form values.
+type Fields struct {
+	Fields map[string]string `json:"fields,omitempty"`
+}
+
+// Bytes carries a single API response or stream frame, identical to the raw body an HTTP call to
+// the equivalent /api/<path> route would return.
+type Bytes struct {
+	Data []byte `json:"data,omitempty"`
+}
+
+// codecName is the grpc content-subtype these messages are marshalled under; see jsonCodec.
+const codecName = "bbsjson"
+
+// jsonCodec implements google.golang.org/grpc/encoding.Codec by marshalling the message types
+// above as JSON. It stands in for the protobuf codec until this package is regenerated by protoc.
+type jsonCodec struct{}
+
+func (jsonCodec) Name() string { return codecName }
+
+func (jsonCodec) Marshal(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (jsonCodec) Unmarshal(data []byte, v interface{}) error {
+	return json.Unmarshal(data, v)
+}
+
+func init() {
+	encoding.RegisterCodec(jsonCodec{})
+}
@@ -0,0 +1,50 @@
+package bbsnode
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Backend performs a single request/response call against path with values, the same way the
+// bbsnode's own HTTP API already does for GetBoards, NewBoard, and the rest of the non-streaming
+// verbs. GRPCServer delegates every unary RPC to a Backend instead of reimplementing board/thread
+// storage a second time.
+type Backend interface {
+	Do(ctx context.Context, path string, values map[string]string) ([]byte, error)
+}
+
+// HTTPBackend is a Backend that forwards to the bbsnode's own HTTP API over loopback, so the gRPC
+// and HTTP surfaces are served by one implementation of the verbs.
+type HTTPBackend struct {
+	BaseURL string // e.g. "http://127.0.0.1:8080"
+	Client  *http.Client
+}
+
+// Do implements Backend.
+func (b *HTTPBackend) Do(ctx context.Context, path string, values map[string]string) ([]byte, error) {
+	data := url.Values{}
+	for k, v := range values {
+		data.Set(k, v)
+	}
+
+	req, e := http.NewRequestWithContext(ctx, http.MethodPost, b.BaseURL+"/api/"+path, strings.NewReader(data.Encode()))
+	if e != nil {
+		return nil, e
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	client := b.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, e := client.Do(req)
+	if e != nil {
+		return nil, e
+	}
+	defer resp.Body.Close()
+
+	return ioutil.ReadAll(resp.Body)
+}
@@ -0,0 +1,128 @@
+package bbsnode
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/MaxWed/bbs/src/bbsnode/api"
+)
+
+// GRPCServer adapts a Hub and a Backend to the bbsnode.api.APIServer interface, so both
+// subscriptions and the regular board/thread/post verbs can be reached over gRPC as well as HTTP.
+// The non-streaming verbs are forwarded to Backend rather than reimplemented here, so gRPC and
+// HTTP clients are always served by the same underlying logic.
+type GRPCServer struct {
+	Hub     *Hub
+	Backend Backend
+}
+
+var _ api.APIServer = (*GRPCServer)(nil)
+
+// NewGRPCServer returns a GRPCServer backed by hub and backend.
+func NewGRPCServer(hub *Hub, backend Backend) *GRPCServer {
+	return &GRPCServer{Hub: hub, Backend: backend}
+}
+
+// do forwards path to s.Backend with values and wraps the result in a Bytes message.
+func (s *GRPCServer) do(ctx context.Context, path string, values map[string]string) (*api.Bytes, error) {
+	if s.Backend == nil {
+		return nil, status.Errorf(codes.Unavailable, "bbsnode: no backend configured for %s", path)
+	}
+	data, e := s.Backend.Do(ctx, path, values)
+	if e != nil {
+		return nil, e
+	}
+	return &api.Bytes{Data: data}, nil
+}
+
+func (s *GRPCServer) GetBoards(ctx context.Context, in *api.Empty) (*api.Bytes, error) {
+	return s.do(ctx, "get_boards", nil)
+}
+
+func (s *GRPCServer) NewBoard(ctx context.Context, in *api.Fields) (*api.Bytes, error) {
+	return s.do(ctx, "new_board", in.Fields)
+}
+
+func (s *GRPCServer) RemoveBoard(ctx context.Context, in *api.Fields) (*api.Bytes, error) {
+	return s.do(ctx, "remove_board", in.Fields)
+}
+
+func (s *GRPCServer) GetBoardPage(ctx context.Context, in *api.Fields) (*api.Bytes, error) {
+	return s.do(ctx, "get_boardpage", in.Fields)
+}
+
+func (s *GRPCServer) GetThreads(ctx context.Context, in *api.Fields) (*api.Bytes, error) {
+	return s.do(ctx, "get_threads", in.Fields)
+}
+
+func (s *GRPCServer) NewThread(ctx context.Context, in *api.Fields) (*api.Bytes, error) {
+	return s.do(ctx, "new_thread", in.Fields)
+}
+
+func (s *GRPCServer) RemoveThread(ctx context.Context, in *api.Fields) (*api.Bytes, error) {
+	return s.do(ctx, "remove_thread", in.Fields)
+}
+
+func (s *GRPCServer) GetThreadPage(ctx context.Context, in *api.Fields) (*api.Bytes, error) {
+	return s.do(ctx, "get_threadpage", in.Fields)
+}
+
+func (s *GRPCServer) GetPosts(ctx context.Context, in *api.Fields) (*api.Bytes, error) {
+	return s.do(ctx, "get_posts", in.Fields)
+}
+
+func (s *GRPCServer) NewPost(ctx context.Context, in *api.Fields) (*api.Bytes, error) {
+	return s.do(ctx, "new_post", in.Fields)
+}
+
+func (s *GRPCServer) RemovePost(ctx context.Context, in *api.Fields) (*api.Bytes, error) {
+	return s.do(ctx, "remove_post", in.Fields)
+}
+
+func (s *GRPCServer) ImportThread(ctx context.Context, in *api.Fields) (*api.Bytes, error) {
+	return s.do(ctx, "import_thread", in.Fields)
+}
+
+func (s *GRPCServer) SubscribeBoard(in *api.Fields, stream api.API_SubscribeBoardServer) error {
+	return s.subscribe("subscribe_board", in, stream.Context(), stream.Send)
+}
+
+func (s *GRPCServer) SubscribeThread(in *api.Fields, stream api.API_SubscribeThreadServer) error {
+	return s.subscribe("subscribe_thread", in, stream.Context(), stream.Send)
+}
+
+func (s *GRPCServer) SubscribeBoardList(in *api.Fields, stream api.API_SubscribeBoardListServer) error {
+	return s.subscribe("subscribe_boardlist", in, stream.Context(), stream.Send)
+}
+
+// subscribe relays Hub events for path to send until the stream's context is cancelled, resuming
+// from the "after" field the same way Server.handleSubscribe resumes from the "after" query
+// parameter over HTTP.
+func (s *GRPCServer) subscribe(path string, in *api.Fields, ctx context.Context, send func(*api.Bytes) error) error {
+	after, _ := strconv.ParseUint(in.Fields["after"], 10, 64)
+
+	events, unsubscribe := s.Hub.Subscribe(path, in.Fields["board"], in.Fields["thread"], after)
+	defer unsubscribe()
+
+	for {
+		select {
+		case ev, ok := <-events:
+			if !ok {
+				return nil
+			}
+			data, err := json.Marshal(ev)
+			if err != nil {
+				return err
+			}
+			if err := send(&api.Bytes{Data: data}); err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package bbsnode
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/websocket"
+)
+
+var upgrader = websocket.Upgrader{}
+
+// Server serves the bbsnode HTTP API routes that back gui.SubscribeFunc; it is the counterpart to
+// gui.HTTPTransport.Stream.
+type Server struct {
+	Hub *Hub
+}
+
+// NewServer returns a Server backed by hub.
+func NewServer(hub *Hub) *Server {
+	return &Server{Hub: hub}
+}
+
+// RegisterRoutes adds the subscription routes to mux, under the same /api/<path> convention the
+// rest of the bbsnode HTTP API uses.
+func (s *Server) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("/api/subscribe_board", s.handleSubscribe("subscribe_board"))
+	mux.HandleFunc("/api/subscribe_thread", s.handleSubscribe("subscribe_thread"))
+	mux.HandleFunc("/api/subscribe_boardlist", s.handleSubscribe("subscribe_boardlist"))
+}
+
+// handleSubscribe upgrades the request to a websocket and streams Events from the hub for path,
+// resuming after the "after" query parameter's sequence number.
+func (s *Server) handleSubscribe(path string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		after, _ := strconv.ParseUint(q.Get("after"), 10, 64)
+
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		events, unsubscribe := s.Hub.Subscribe(path, q.Get("board"), q.Get("thread"), after)
+		defer unsubscribe()
+
+		ctx := r.Context()
+		for {
+			select {
+			case ev, ok := <-events:
+				if !ok {
+					return
+				}
+				if err := conn.WriteJSON(ev); err != nil {
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
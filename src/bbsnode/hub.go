@@ -0,0 +1,102 @@
+// Package bbsnode implements the server side of the real-time subscription and gRPC surfaces that
+// the gui package's SubscribeFunc and GRPCTransport talk to.
+package bbsnode
+
+import (
+	"sync"
+
+	"github.com/MaxWed/bbs/src/gui"
+)
+
+// scope identifies the set of subscribers a published Event is delivered to.
+type scope struct {
+	path   string // e.g. "subscribe_board"
+	board  string
+	thread string
+}
+
+// Hub fans published Events out to subscribers, keeping enough history per scope that a
+// reconnecting subscriber can resume from the sequence number it last saw instead of missing
+// events raised while it was disconnected.
+type Hub struct {
+	mu      sync.Mutex
+	seq     uint64
+	history map[scope][]gui.Event
+	subs    map[scope]map[chan gui.Event]struct{}
+}
+
+// NewHub returns an empty Hub.
+func NewHub() *Hub {
+	return &Hub{
+		history: make(map[scope][]gui.Event),
+		subs:    make(map[scope]map[chan gui.Event]struct{}),
+	}
+}
+
+// maxHistory bounds how many past events a scope retains for resuming subscribers.
+const maxHistory = 256
+
+// Publish delivers ev to every subscriber of (path, board, thread) and records it so that
+// subscribers connecting afterwards can resume from ev.Seq.
+func (h *Hub) Publish(path, board, thread string, ev gui.Event) gui.Event {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.seq++
+	ev.Seq = h.seq
+
+	key := scope{path: path, board: board, thread: thread}
+	hist := append(h.history[key], ev)
+	if len(hist) > maxHistory {
+		hist = hist[len(hist)-maxHistory:]
+	}
+	h.history[key] = hist
+
+	for ch := range h.subs[key] {
+		select {
+		case ch <- ev:
+		default:
+			// A slow subscriber is cut off rather than blocking Publish: closing its channel ends
+			// its stream, so it reconnects, at which point Subscribe replays whatever history
+			// remains.
+			delete(h.subs[key], ch)
+			close(ch)
+		}
+	}
+	return ev
+}
+
+// Subscribe registers a new subscriber for (path, board, thread), replaying any retained history
+// after the given sequence number before delivering new events. The returned func unregisters the
+// subscriber and must be called once the caller is done reading from the channel.
+func (h *Hub) Subscribe(path, board, thread string, after uint64) (<-chan gui.Event, func()) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	// The buffer must hold a full history replay without blocking: Publish and other Subscribe
+	// calls need h.mu while this one still holds it, so a send that blocked here would deadlock
+	// the whole Hub rather than just this subscriber.
+	key := scope{path: path, board: board, thread: thread}
+	ch := make(chan gui.Event, maxHistory)
+	if h.subs[key] == nil {
+		h.subs[key] = make(map[chan gui.Event]struct{})
+	}
+	h.subs[key][ch] = struct{}{}
+
+	for _, ev := range h.history[key] {
+		if ev.Seq > after {
+			ch <- ev
+		}
+	}
+
+	unsubscribe := func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		// Publish may already have cut this subscriber off and closed ch.
+		if _, ok := h.subs[key][ch]; ok {
+			delete(h.subs[key], ch)
+			close(ch)
+		}
+	}
+	return ch, unsubscribe
+}
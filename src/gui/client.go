@@ -2,11 +2,7 @@ package gui
 
 import (
 	"context"
-	"fmt"
 	"github.com/pkg/errors"
-	"io/ioutil"
-	"net/http"
-	"net/url"
 )
 
 var (
@@ -14,13 +10,31 @@ var (
 )
 
 type (
-	// ClientFunc is a client function.
-	ClientFunc func(ctx context.Context, port int) ([]byte, error)
+	// ClientFunc is a client function that performs a single request-response call over a
+	// Transport.
+	ClientFunc func(ctx context.Context, t Transport) ([]byte, error)
 
 	// Values represents key/value pairs.
 	Values map[string]*string
 )
 
+// Transport abstracts the connection a ClientFunc or SubscribeFunc is carried over, so that the
+// API surface above does not care whether it is talking to a local bbsnode over HTTP, a remote
+// one over gRPC, or anything else.
+type Transport interface {
+	// Do performs a single request-response call to path with the given values.
+	Do(ctx context.Context, path string, values Values) ([]byte, error)
+	// Stream opens a persistent connection to path and delivers raw frames to the returned
+	// channel until the connection drops or ctx is cancelled, at which point it is closed.
+	Stream(ctx context.Context, path string, values Values) (<-chan []byte, error)
+}
+
+// Call invokes fn against the bbsnode API served on 127.0.0.1:port. It keeps the pre-Transport,
+// port-based calling convention available to callers.
+func Call(ctx context.Context, port int, fn ClientFunc) ([]byte, error) {
+	return fn(ctx, &HTTPTransport{Host: "127.0.0.1", Port: port})
+}
+
 /*
 	<<< FOR BOARDS, THREADS & POSTS >>>
 */
@@ -126,46 +140,16 @@ func ImportThread(fromBoard, thread, toBoard *string) ClientFunc {
 	<<< HELPER FUNCTIONS >>>
 */
 
-// Asynchronously requests from api.
-func request(port int, path string, data url.Values) (chan []byte, chan error) {
-	bChan, eChan := make(chan []byte), make(chan error)
-	go func() {
-		resp, e := http.PostForm(
-			fmt.Sprintf("http://127.0.0.1:%d/api/%s", port, path),
-			data,
-		)
-		if e != nil {
-			eChan <- e
-			return
-		}
-		defer resp.Body.Close()
-		body, e := ioutil.ReadAll(resp.Body)
-		if e != nil {
-			eChan <- e
-			return
-		}
-		bChan <- body
-		return
-	}()
-	return bChan, eChan
-}
-
 // Generates a method of requesting data from api.
 func gen(path string, values Values) ClientFunc {
-	return func(ctx context.Context, port int) ([]byte, error) {
-		// Get values.
-		urlValues := url.Values{}
-		for k, v := range values {
-			urlValues[k] = []string{*v}
-		}
-		bChan, eChan := request(port, path, urlValues)
-		select {
-		case <-ctx.Done():
-			return nil, ErrTimeout
-		case e := <-eChan:
+	return func(ctx context.Context, t Transport) ([]byte, error) {
+		body, e := t.Do(ctx, path, values)
+		if e != nil {
+			if errors.Is(e, context.Canceled) || errors.Is(e, context.DeadlineExceeded) {
+				return nil, ErrTimeout
+			}
 			return nil, e
-		case body := <-bChan:
-			return body, nil
 		}
+		return body, nil
 	}
-}
\ No newline at end of file
+}
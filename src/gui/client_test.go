@@ -0,0 +1,50 @@
+package gui
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"runtime"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// TestGenCancelReleasesGoroutine ensures that cancelling the caller's context aborts the
+// in-flight request instead of leaving the underlying goroutine and connection running until the
+// (deliberately slow) server responds.
+func TestGenCancelReleasesGoroutine(t *testing.T) {
+	unblock := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-unblock
+	}))
+	defer srv.Close()
+	defer close(unblock)
+
+	u, err := url.Parse(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	port, err := strconv.Atoi(u.Port())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	before := runtime.NumGoroutine()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	fn := gen("slow", nil)
+	if _, err := Call(ctx, port, fn); err != ErrTimeout {
+		t.Fatalf("expected ErrTimeout, got %v", err)
+	}
+
+	// Give the aborted request's goroutine a chance to unwind before checking.
+	time.Sleep(100 * time.Millisecond)
+
+	if after := runtime.NumGoroutine(); after > before+1 {
+		t.Fatalf("goroutine leak after cancel: before=%d after=%d", before, after)
+	}
+}
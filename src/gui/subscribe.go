@@ -0,0 +1,170 @@
+package gui
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// EventType identifies the kind of change an Event describes.
+type EventType string
+
+const (
+	ThreadAdded      EventType = "thread_added"
+	ThreadRemoved    EventType = "thread_removed"
+	PostAdded        EventType = "post_added"
+	PostRemoved      EventType = "post_removed"
+	BoardMetaChanged EventType = "board_meta_changed"
+)
+
+// Event is a single change delivered by a subscription. Seq is monotonically increasing per
+// subscription and is used to resume a subscription after a reconnect without missing events.
+type Event struct {
+	Seq    uint64          `json:"seq"`
+	Type   EventType       `json:"type"`
+	Board  string          `json:"board,omitempty"`
+	Thread string          `json:"thread,omitempty"`
+	Data   json.RawMessage `json:"data,omitempty"`
+}
+
+// SubscribeFunc opens a persistent subscription over a Transport and streams Events to the
+// returned channel until ctx is cancelled, at which point the channel is closed.
+type SubscribeFunc func(ctx context.Context, t Transport) (<-chan Event, error)
+
+// Backoff bounds used between reconnect attempts.
+const (
+	minBackoff = 500 * time.Millisecond
+	maxBackoff = 30 * time.Second
+)
+
+// errStreamClosed is returned by streamOnce when the stream ends without ctx being cancelled.
+// runSubscription handles it like any other stream error: it waits out the backoff and reconnects.
+var errStreamClosed = errors.New("stream closed")
+
+/*
+	<<< FOR BOARDS, THREADS & POSTS >>>
+*/
+
+// SubscribeBoard subscribes to thread and meta changes of a specified board.
+func SubscribeBoard(board *string) SubscribeFunc {
+	return subscribe("subscribe_board", Values{
+		"board": board,
+	})
+}
+
+// SubscribeThread subscribes to post changes of a specified thread.
+func SubscribeThread(board, thread *string) SubscribeFunc {
+	return subscribe("subscribe_thread", Values{
+		"board":  board,
+		"thread": thread,
+	})
+}
+
+// SubscribeBoardList subscribes to changes of the list of boards the bbsnode is subscribed to.
+func SubscribeBoardList() SubscribeFunc {
+	return subscribe("subscribe_boardlist", nil)
+}
+
+/*
+	<<< HELPER FUNCTIONS >>>
+*/
+
+// Subscribe invokes fn against the bbsnode API served on 127.0.0.1:port. It keeps the
+// pre-Transport, port-based calling convention available to callers.
+func Subscribe(ctx context.Context, port int, fn SubscribeFunc) (<-chan Event, error) {
+	return fn(ctx, &HTTPTransport{Host: "127.0.0.1", Port: port})
+}
+
+// Generates a method of subscribing to events from the api, reconnecting with exponential
+// backoff and resuming from the last seen sequence number so that no events are missed.
+func subscribe(path string, values Values) SubscribeFunc {
+	return func(ctx context.Context, t Transport) (<-chan Event, error) {
+		events := make(chan Event)
+		go runSubscription(ctx, t, path, values, events)
+		return events, nil
+	}
+}
+
+func runSubscription(ctx context.Context, t Transport, path string, values Values, events chan<- Event) {
+	defer close(events)
+
+	var lastSeq uint64
+	backoff := minBackoff
+	for {
+		if ctx.Err() != nil {
+			return
+		}
+
+		seq, err := streamOnce(ctx, t, path, values, lastSeq, events)
+		lastSeq = seq
+		if ctx.Err() != nil {
+			return
+		}
+		if err == nil {
+			backoff = minBackoff
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(jitter(backoff)):
+		}
+		backoff *= 2
+		if backoff > maxBackoff {
+			backoff = maxBackoff
+		}
+	}
+}
+
+// streamOnce opens a single stream over t resuming after the given sequence number, and delivers
+// events until the stream ends or ctx is cancelled. It returns the sequence number of the last
+// event delivered, so the caller can resume from there on the next attempt.
+func streamOnce(ctx context.Context, t Transport, path string, values Values, after uint64, events chan<- Event) (uint64, error) {
+	// attemptCtx scopes this single connection attempt: cancelling it (on any return path) tells
+	// the Transport to tear down its connection and stop feeding frames, rather than leaving it
+	// running in the background once streamOnce has moved on.
+	attemptCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	withAfter := make(Values, len(values)+1)
+	for k, v := range values {
+		withAfter[k] = v
+	}
+	afterStr := fmt.Sprintf("%d", after)
+	withAfter["after"] = &afterStr
+
+	frames, err := t.Stream(attemptCtx, path, withAfter)
+	if err != nil {
+		return after, err
+	}
+
+	for frame := range frames {
+		var ev Event
+		if err := json.Unmarshal(frame, &ev); err != nil {
+			return after, err
+		}
+		after = ev.Seq
+
+		select {
+		case events <- ev:
+		case <-ctx.Done():
+			return after, ctx.Err()
+		}
+	}
+	if ctx.Err() != nil {
+		return after, ctx.Err()
+	}
+	return after, errStreamClosed
+}
+
+func jitter(d time.Duration) time.Duration {
+	if d <= 0 {
+		return 0
+	}
+	return d/2 + time.Duration(rand.Int63n(int64(d)))
+}
@@ -0,0 +1,131 @@
+package gui
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+
+	pb "github.com/MaxWed/bbs/src/bbsnode/api"
+)
+
+// GRPCTransport is a Transport that talks to a bbsnode over its gRPC API (see
+// src/bbsnode/api/api.proto), allowing remote administration, TLS, and a single connection to be
+// reused across every call instead of a fresh TCP connection per request.
+type GRPCTransport struct {
+	Conn   *grpc.ClientConn
+	client pb.APIClient
+}
+
+// NewGRPCTransport dials addr and returns a ready-to-use GRPCTransport.
+func NewGRPCTransport(ctx context.Context, addr string, opts ...grpc.DialOption) (*GRPCTransport, error) {
+	conn, e := grpc.DialContext(ctx, addr, opts...)
+	if e != nil {
+		return nil, e
+	}
+	return &GRPCTransport{Conn: conn, client: pb.NewAPIClient(conn)}, nil
+}
+
+// Do implements Transport by invoking the gRPC method matching path.
+func (t *GRPCTransport) Do(ctx context.Context, path string, values Values) ([]byte, error) {
+	req := &pb.Fields{Fields: toFieldMap(values)}
+
+	var (
+		resp *pb.Bytes
+		e    error
+	)
+	switch path {
+	case "get_boards":
+		resp, e = t.client.GetBoards(ctx, &pb.Empty{})
+	case "new_board":
+		resp, e = t.client.NewBoard(ctx, req)
+	case "remove_board":
+		resp, e = t.client.RemoveBoard(ctx, req)
+	case "get_boardpage":
+		resp, e = t.client.GetBoardPage(ctx, req)
+	case "get_threads":
+		resp, e = t.client.GetThreads(ctx, req)
+	case "new_thread":
+		resp, e = t.client.NewThread(ctx, req)
+	case "remove_thread":
+		resp, e = t.client.RemoveThread(ctx, req)
+	case "get_threadpage":
+		resp, e = t.client.GetThreadPage(ctx, req)
+	case "get_posts":
+		resp, e = t.client.GetPosts(ctx, req)
+	case "new_post":
+		resp, e = t.client.NewPost(ctx, req)
+	case "remove_post":
+		resp, e = t.client.RemovePost(ctx, req)
+	case "import_thread":
+		resp, e = t.client.ImportThread(ctx, req)
+	default:
+		return nil, errors.Errorf("gui: unknown gRPC method %q", path)
+	}
+	if e != nil {
+		return nil, e
+	}
+	return resp.Data, nil
+}
+
+// Stream implements Transport by opening the gRPC server-stream matching path.
+func (t *GRPCTransport) Stream(ctx context.Context, path string, values Values) (<-chan []byte, error) {
+	req := &pb.Fields{Fields: toFieldMap(values)}
+
+	recv, e := t.openStream(ctx, path, req)
+	if e != nil {
+		return nil, e
+	}
+
+	frames := make(chan []byte)
+	go func() {
+		defer close(frames)
+		for {
+			msg, e := recv()
+			if e != nil {
+				return
+			}
+			select {
+			case frames <- msg.Data:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return frames, nil
+}
+
+// openStream dials the gRPC stream matching path and returns a closure for receiving frames,
+// abstracting away the distinct stream type each generated RPC returns.
+func (t *GRPCTransport) openStream(ctx context.Context, path string, req *pb.Fields) (func() (*pb.Bytes, error), error) {
+	switch path {
+	case "subscribe_board":
+		s, e := t.client.SubscribeBoard(ctx, req)
+		if e != nil {
+			return nil, e
+		}
+		return s.Recv, nil
+	case "subscribe_thread":
+		s, e := t.client.SubscribeThread(ctx, req)
+		if e != nil {
+			return nil, e
+		}
+		return s.Recv, nil
+	case "subscribe_boardlist":
+		s, e := t.client.SubscribeBoardList(ctx, req)
+		if e != nil {
+			return nil, e
+		}
+		return s.Recv, nil
+	default:
+		return nil, errors.Errorf("gui: unknown gRPC stream %q", path)
+	}
+}
+
+func toFieldMap(values Values) map[string]string {
+	m := make(map[string]string, len(values))
+	for k, v := range values {
+		m[k] = *v
+	}
+	return m
+}
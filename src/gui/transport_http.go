@@ -0,0 +1,130 @@
+package gui
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync/atomic"
+
+	"github.com/gorilla/websocket"
+)
+
+// Options configures package-level behaviour of HTTPTransport.
+type Options struct {
+	// HTTPClient, if set, replaces the default *http.Client used by HTTPTransport to perform
+	// requests.
+	HTTPClient *http.Client
+}
+
+// defaultHTTPClient is read from arbitrary request goroutines via getDefaultHTTPClient, so it is
+// held behind an atomic.Value rather than a bare var to keep SetHTTPClient race-free with
+// concurrent Do/Stream calls.
+var defaultHTTPClient atomic.Value // holds *http.Client
+
+func init() {
+	defaultHTTPClient.Store(http.DefaultClient)
+}
+
+// SetHTTPClient applies opts to the package-level HTTP client used by HTTPTransport, e.g. to
+// configure timeouts, TLS, or transport-level connection pooling.
+func SetHTTPClient(opts Options) {
+	client := opts.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	defaultHTTPClient.Store(client)
+}
+
+func getDefaultHTTPClient() *http.Client {
+	return defaultHTTPClient.Load().(*http.Client)
+}
+
+// HTTPTransport is a Transport that talks to a bbsnode over its HTTP API, the same way this
+// package always has.
+type HTTPTransport struct {
+	Host   string
+	Port   int
+	Client *http.Client // defaults to the client set via SetHTTPClient.
+}
+
+func (t *HTTPTransport) client() *http.Client {
+	if t.Client != nil {
+		return t.Client
+	}
+	return getDefaultHTTPClient()
+}
+
+func (t *HTTPTransport) url(path string) string {
+	return fmt.Sprintf("http://%s:%d/api/%s", t.Host, t.Port, path)
+}
+
+// Do implements Transport, aborting the underlying connection as soon as ctx is done.
+func (t *HTTPTransport) Do(ctx context.Context, path string, values Values) ([]byte, error) {
+	data := url.Values{}
+	for k, v := range values {
+		data[k] = []string{*v}
+	}
+
+	req, e := http.NewRequestWithContext(ctx, http.MethodPost, t.url(path), strings.NewReader(data.Encode()))
+	if e != nil {
+		return nil, e
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, e := t.client().Do(req)
+	if e != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		return nil, e
+	}
+	defer resp.Body.Close()
+
+	body, e := ioutil.ReadAll(resp.Body)
+	if e != nil {
+		return nil, e
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, parseAPIError(resp.StatusCode, body)
+	}
+	return body, nil
+}
+
+// Stream implements Transport by opening a websocket connection to path and delivering raw
+// frames until the connection drops or ctx is cancelled.
+func (t *HTTPTransport) Stream(ctx context.Context, path string, values Values) (<-chan []byte, error) {
+	q := url.Values{}
+	for k, v := range values {
+		q.Set(k, *v)
+	}
+	u := fmt.Sprintf("ws://%s:%d/api/%s?%s", t.Host, t.Port, path, q.Encode())
+
+	conn, _, e := websocket.DefaultDialer.DialContext(ctx, u, nil)
+	if e != nil {
+		return nil, e
+	}
+
+	frames := make(chan []byte)
+	go func() {
+		<-ctx.Done()
+		conn.Close()
+	}()
+	go func() {
+		defer close(frames)
+		for {
+			_, msg, e := conn.ReadMessage()
+			if e != nil {
+				return
+			}
+			select {
+			case frames <- msg:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return frames, nil
+}
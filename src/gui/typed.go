@@ -0,0 +1,230 @@
+package gui
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+/*
+	<<< ERRORS >>>
+*/
+
+// APIError is a structured error returned by the bbsnode API.
+type APIError struct {
+	Code       int    `json:"code"`
+	Message    string `json:"message"`
+	Details    string `json:"details,omitempty"`
+	HTTPStatus int    `json:"-"`
+}
+
+func (e *APIError) Error() string {
+	if e.Details != "" {
+		return fmt.Sprintf("%s: %s", e.Message, e.Details)
+	}
+	return e.Message
+}
+
+// Is reports whether target is an *APIError with the same Code, so that callers can use
+// errors.Is(err, ErrNotSubscribed) and friends.
+func (e *APIError) Is(target error) bool {
+	t, ok := target.(*APIError)
+	return ok && t.Code == e.Code
+}
+
+// Sentinel error codes returned by the bbsnode API.
+const (
+	CodeNotSubscribed    = 1001
+	CodeBadSeed          = 1002
+	CodePermissionDenied = 1003
+)
+
+var (
+	ErrNotSubscribed    = &APIError{Code: CodeNotSubscribed, Message: "not subscribed to board"}
+	ErrBadSeed          = &APIError{Code: CodeBadSeed, Message: "bad seed"}
+	ErrPermissionDenied = &APIError{Code: CodePermissionDenied, Message: "permission denied"}
+)
+
+// parseAPIError parses a non-200 API response body into an *APIError carrying status.
+func parseAPIError(status int, body []byte) error {
+	var apiErr APIError
+	if e := json.Unmarshal(body, &apiErr); e != nil {
+		apiErr = APIError{Message: string(body)}
+	}
+	apiErr.HTTPStatus = status
+	return &apiErr
+}
+
+/*
+	<<< RESPONSE TYPES >>>
+*/
+
+// Board describes a board as returned by the bbsnode API.
+type Board struct {
+	PubKey              string `json:"pub_key"`
+	Name                string `json:"name"`
+	Description         string `json:"description"`
+	SubmissionAddresses string `json:"submission_addresses"`
+}
+
+// BoardPage describes the board and its threads, as returned by GetBoardPage.
+type BoardPage struct {
+	Board   Board    `json:"board"`
+	Threads []Thread `json:"threads"`
+}
+
+// Thread describes a thread as returned by the bbsnode API.
+type Thread struct {
+	Ref         string `json:"ref"`
+	Board       string `json:"board"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// ThreadPage describes the thread and its posts, as returned by GetThreadPage.
+type ThreadPage struct {
+	Thread Thread `json:"thread"`
+	Posts  []Post `json:"posts"`
+}
+
+// Post describes a post as returned by the bbsnode API.
+type Post struct {
+	Ref    string `json:"ref"`
+	Thread string `json:"thread"`
+	Title  string `json:"title"`
+	Body   string `json:"body"`
+}
+
+// NewBoardResult is returned once a board has been created.
+type NewBoardResult struct {
+	Board Board  `json:"board"`
+	Seed  string `json:"seed"`
+}
+
+/*
+	<<< TYPED CLIENT FUNCTIONS >>>
+*/
+
+// GetBoardsTyped obtains boards in which the bbsnode is subscribed.
+func GetBoardsTyped(ctx context.Context, t Transport) ([]Board, error) {
+	var boards []Board
+	if e := decode(ctx, t, GetBoards(), &boards); e != nil {
+		return nil, e
+	}
+	return boards, nil
+}
+
+// NewBoardTyped creates a new board.
+func NewBoardTyped(ctx context.Context, t Transport, boardName, boardDescription, boardSubmissionAddresses, seed *string) (*NewBoardResult, error) {
+	var result NewBoardResult
+	if e := decode(ctx, t, NewBoard(boardName, boardDescription, boardSubmissionAddresses, seed), &result); e != nil {
+		return nil, e
+	}
+	return &result, nil
+}
+
+// RemoveBoardTyped removes a board.
+func RemoveBoardTyped(ctx context.Context, t Transport, board *string) error {
+	return decode(ctx, t, RemoveBoard(board), nil)
+}
+
+// GetBoardPageTyped obtains the board page of specified board of public key.
+func GetBoardPageTyped(ctx context.Context, t Transport, board *string) (*BoardPage, error) {
+	var page BoardPage
+	if e := decode(ctx, t, GetBoardPage(board), &page); e != nil {
+		return nil, e
+	}
+	return &page, nil
+}
+
+// GetThreadsTyped obtains threads of a specified board of public key.
+func GetThreadsTyped(ctx context.Context, t Transport, board *string) ([]Thread, error) {
+	var threads []Thread
+	if e := decode(ctx, t, GetThreads(board), &threads); e != nil {
+		return nil, e
+	}
+	return threads, nil
+}
+
+// NewThreadTyped creates a new thread on specified board.
+func NewThreadTyped(ctx context.Context, t Transport, board, threadName, threadDescription *string) (*Thread, error) {
+	var thread Thread
+	if e := decode(ctx, t, NewThread(board, threadName, threadDescription), &thread); e != nil {
+		return nil, e
+	}
+	return &thread, nil
+}
+
+// RemoveThreadTyped removes a thread on specified board.
+func RemoveThreadTyped(ctx context.Context, t Transport, board, thread *string) error {
+	return decode(ctx, t, RemoveThread(board, thread), nil)
+}
+
+// GetThreadPageTyped obtains a thread page of specified board and thread.
+func GetThreadPageTyped(ctx context.Context, t Transport, board, thread *string) (*ThreadPage, error) {
+	var page ThreadPage
+	if e := decode(ctx, t, GetThreadPage(board, thread), &page); e != nil {
+		return nil, e
+	}
+	return &page, nil
+}
+
+// GetPostsTyped obtains the posts of a thread of specified board and thread.
+func GetPostsTyped(ctx context.Context, t Transport, board, thread *string) ([]Post, error) {
+	var posts []Post
+	if e := decode(ctx, t, GetPosts(board, thread), &posts); e != nil {
+		return nil, e
+	}
+	return posts, nil
+}
+
+// NewPostTyped creates a new post on specified board and thread.
+func NewPostTyped(ctx context.Context, t Transport, board, thread, postTitle, postBody *string) (*Post, error) {
+	var post Post
+	if e := decode(ctx, t, NewPost(board, thread, postTitle, postBody), &post); e != nil {
+		return nil, e
+	}
+	return &post, nil
+}
+
+// RemovePostTyped removes a post in specified board, thread and post reference.
+func RemovePostTyped(ctx context.Context, t Transport, board, thread, post *string) error {
+	return decode(ctx, t, RemovePost(board, thread, post), nil)
+}
+
+// ImportThreadTyped imports a thread from a board to another.
+func ImportThreadTyped(ctx context.Context, t Transport, fromBoard, thread, toBoard *string) (*Thread, error) {
+	var imported Thread
+	if e := decode(ctx, t, ImportThread(fromBoard, thread, toBoard), &imported); e != nil {
+		return nil, e
+	}
+	return &imported, nil
+}
+
+/*
+	<<< HELPER FUNCTIONS >>>
+*/
+
+// envelope is the JSON shape returned by the bbsnode API for typed endpoints.
+type envelope struct {
+	Data json.RawMessage `json:"data"`
+}
+
+// decode runs fn against t and unmarshals its JSON envelope's data into out. It takes a Transport
+// rather than a port so the typed surface works over gRPC as well as HTTP; callers that still want
+// the port-based convention can pass &HTTPTransport{Host: "127.0.0.1", Port: port}.
+func decode(ctx context.Context, t Transport, fn ClientFunc, out interface{}) error {
+	body, e := fn(ctx, t)
+	if e != nil {
+		return e
+	}
+
+	var env envelope
+	if e := json.Unmarshal(body, &env); e != nil {
+		return e
+	}
+	if out == nil {
+		return nil
+	}
+	return json.Unmarshal(env.Data, out)
+}